@@ -0,0 +1,119 @@
+// Command describe prints summary statistics for a CSV file.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+
+	"github.com/roman91DE/describe/pkg/describe"
+)
+
+func main() {
+	input := flag.String("input", "test_data/titanic.csv", "path to the input CSV file")
+	format := flag.String("format", "text", "output format: text, json, csv, markdown, html")
+	output := flag.String("output", "", "path to write the report to (default stdout)")
+	streaming := flag.Bool("streaming", false, "summarize in a single pass using approximate statistics, without loading the file into memory (for files too large to fit in RAM)")
+	correlations := flag.Bool("correlations", false, "print a Pearson correlation matrix and flag significant cross-column associations (requires non-streaming mode)")
+	pvalueThreshold := flag.Float64("pvalue-threshold", 0.05, "p-value below which a chi-square or t-test association is flagged as significant")
+	encoding := flag.String("encoding", "auto", "input character encoding: auto, utf8, utf16le, utf16be, gbk, latin1")
+	flag.Parse()
+
+	opts := describe.DefaultOptions()
+	opts.Encoding = describe.Encoding(*encoding)
+
+	file, err := os.Open(*input)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	var report describe.Report
+	var df *describe.DataFrame
+	if *streaming {
+		report, err = describe.StreamCSV(file, opts)
+	} else {
+		df, err = describe.ReadCSV(file, opts)
+		if err == nil {
+			report = df.Describe()
+		}
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	rep, err := describe.ReporterFor(*format, opts.Separator)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	w := os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if err := describe.Render(w, rep, report); err != nil {
+		log.Fatal(err)
+	}
+
+	if *correlations {
+		if df == nil {
+			log.Fatal("--correlations requires non-streaming mode")
+		}
+		printCorrelations(w, df, *pvalueThreshold)
+	}
+}
+
+// printCorrelations writes a Pearson correlation matrix over df's numeric
+// columns, followed by every chi-square (categorical vs categorical) and
+// Welch's t-test (numeric vs binary categorical) association whose p-value
+// falls below threshold.
+func printCorrelations(w *os.File, df *describe.DataFrame, threshold float64) {
+	matrix := df.Correlation()
+
+	fmt.Fprintln(w, "\nCorrelation matrix (Pearson):")
+	fmt.Fprintf(w, "%10s", "")
+	for _, c := range matrix.Columns {
+		fmt.Fprintf(w, "%10s", c)
+	}
+	fmt.Fprintln(w)
+	for i, row := range matrix.Matrix {
+		fmt.Fprintf(w, "%10s", matrix.Columns[i])
+		for _, v := range row {
+			fmt.Fprintf(w, "%10.3f", v)
+		}
+		fmt.Fprintln(w)
+	}
+
+	var categoricalCols []string
+	for name := range df.Categorical {
+		categoricalCols = append(categoricalCols, name)
+	}
+	sort.Strings(categoricalCols)
+
+	fmt.Fprintln(w, "\nSignificant associations (p <", threshold, "):")
+	for _, numCol := range matrix.Columns {
+		for _, groupCol := range categoricalCols {
+			if result, err := df.TTest(numCol, groupCol); err == nil && result.PValue < threshold {
+				fmt.Fprintf(w, "  t-test %s ~ %s: t=%.3f dof=%.1f p=%.4g\n", numCol, groupCol, result.Statistic, result.DOF, result.PValue)
+			}
+		}
+	}
+	for _, name := range categoricalCols {
+		for _, other := range categoricalCols {
+			if other <= name {
+				continue
+			}
+			if result, err := df.ChiSquare(name, other); err == nil && result.PValue < threshold {
+				fmt.Fprintf(w, "  chi-square %s x %s: stat=%.3f dof=%d p=%.4g\n", name, other, result.Statistic, result.DOF, result.PValue)
+			}
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package describe
+
+import "math"
+
+// regularizedIncompleteBeta returns I_x(a, b), used below to turn a
+// Student's t statistic into a two-tailed p-value. It uses the classic
+// continued-fraction evaluation, applying the symmetry relation
+// I_x(a,b) = 1 - I_{1-x}(b,a) when that converges faster.
+func regularizedIncompleteBeta(a, b, x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+
+	lgA, _ := math.Lgamma(a)
+	lgB, _ := math.Lgamma(b)
+	lgAB, _ := math.Lgamma(a + b)
+	front := math.Exp(lgAB - lgA - lgB + a*math.Log(x) + b*math.Log(1-x))
+
+	if x < (a+1)/(a+b+2) {
+		return front * betaContinuedFraction(a, b, x) / a
+	}
+	return 1 - front*betaContinuedFraction(b, a, 1-x)/b
+}
+
+// betaContinuedFraction evaluates, via Lentz's algorithm, the continued
+// fraction behind the incomplete beta function.
+func betaContinuedFraction(a, b, x float64) float64 {
+	const tiny = 1e-300
+	qab := a + b
+	qap := a + 1
+	qam := a - 1
+
+	c := 1.0
+	d := 1 - qab*x/qap
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	h := d
+
+	for m := 1; m < gammaMaxIterations; m++ {
+		mf := float64(m)
+
+		aa := mf * (b - mf) * x / ((qam + 2*mf) * (a + 2*mf))
+		d = 1 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		h *= d * c
+
+		aa = -(a + mf) * (qab + mf) * x / ((a + 2*mf) * (qap + 2*mf))
+		d = 1 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+
+		if math.Abs(delta-1) < gammaEpsilon {
+			break
+		}
+	}
+
+	return h
+}
+
+// tDistributionPValue returns the two-tailed p-value for a t statistic with
+// the given degrees of freedom, via the incomplete beta function:
+// P(|T| > |t|) = I_{dof/(dof+t^2)}(dof/2, 1/2).
+func tDistributionPValue(t, dof float64) float64 {
+	x := dof / (dof + t*t)
+	return regularizedIncompleteBeta(dof/2, 0.5, x)
+}
@@ -0,0 +1,92 @@
+package describe
+
+import "fmt"
+
+// ChiSquareResult is the outcome of a chi-square test of independence
+// between two categorical columns.
+type ChiSquareResult struct {
+	Statistic float64
+	DOF       int
+	PValue    float64
+}
+
+// ChiSquare runs a chi-square test of independence between the categorical
+// columns colA and colB, which must have the same number of rows.
+func (df *DataFrame) ChiSquare(colA, colB string) (ChiSquareResult, error) {
+	a, ok := df.Categorical[colA]
+	if !ok {
+		return ChiSquareResult{}, fmt.Errorf("describe: column %q is not categorical", colA)
+	}
+	b, ok := df.Categorical[colB]
+	if !ok {
+		return ChiSquareResult{}, fmt.Errorf("describe: column %q is not categorical", colB)
+	}
+	if len(a) != len(b) {
+		return ChiSquareResult{}, fmt.Errorf("describe: columns %q and %q have different lengths (%d vs %d)", colA, colB, len(a), len(b))
+	}
+
+	rowLevels := uniqueOrdered(a)
+	colLevels := uniqueOrdered(b)
+	rowIndex := indexOf(rowLevels)
+	colIndex := indexOf(colLevels)
+
+	observed := make([][]float64, len(rowLevels))
+	rowTotal := make([]float64, len(rowLevels))
+	colTotal := make([]float64, len(colLevels))
+	for i := range observed {
+		observed[i] = make([]float64, len(colLevels))
+	}
+
+	var total float64
+	for i := range a {
+		r, c := rowIndex[a[i]], colIndex[b[i]]
+		observed[r][c]++
+		rowTotal[r]++
+		colTotal[c]++
+		total++
+	}
+
+	if total == 0 {
+		return ChiSquareResult{}, fmt.Errorf("describe: no rows to test")
+	}
+
+	var statistic float64
+	for r := range rowLevels {
+		for c := range colLevels {
+			expected := rowTotal[r] * colTotal[c] / total
+			if expected == 0 {
+				continue
+			}
+			diff := observed[r][c] - expected
+			statistic += diff * diff / expected
+		}
+	}
+
+	dof := (len(rowLevels) - 1) * (len(colLevels) - 1)
+	if dof <= 0 {
+		return ChiSquareResult{Statistic: statistic, DOF: dof, PValue: 1}, nil
+	}
+
+	pValue := upperIncompleteGammaQ(float64(dof)/2, statistic/2)
+	return ChiSquareResult{Statistic: statistic, DOF: dof, PValue: pValue}, nil
+}
+
+func uniqueOrdered(vals []string) []string {
+	seen := make(map[string]bool)
+	var out []string
+	for _, v := range vals {
+		if !seen[v] {
+			seen[v] = true
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func indexOf(levels []string) map[string]int {
+	idx := make(map[string]int, len(levels))
+	for i, v := range levels {
+		idx[v] = i
+	}
+	return idx
+}
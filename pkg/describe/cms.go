@@ -0,0 +1,131 @@
+package describe
+
+import (
+	"container/heap"
+	"hash/fnv"
+)
+
+// countMinSketch is a fixed-size approximate frequency table: Add never
+// allocates per distinct item, and Estimate may overcount (never
+// undercount) due to hash collisions across its depth*width counters.
+type countMinSketch struct {
+	width int
+	depth int
+	table [][]uint32
+}
+
+func newCountMinSketch(width, depth int) *countMinSketch {
+	table := make([][]uint32, depth)
+	for i := range table {
+		table[i] = make([]uint32, width)
+	}
+	return &countMinSketch{width: width, depth: depth, table: table}
+}
+
+func (c *countMinSketch) Add(item string) {
+	for row, idx := range c.indices(item) {
+		c.table[row][idx]++
+	}
+}
+
+func (c *countMinSketch) Estimate(item string) uint32 {
+	var min uint32
+	for row, idx := range c.indices(item) {
+		v := c.table[row][idx]
+		if row == 0 || v < min {
+			min = v
+		}
+	}
+	return min
+}
+
+// indices returns, for each row of the sketch, the counter column that item
+// hashes to, by mixing an FNV-1a hash of item with the row index.
+func (c *countMinSketch) indices(item string) []int {
+	h := fnv.New64a()
+	h.Write([]byte(item))
+	base := h.Sum64()
+
+	idx := make([]int, c.depth)
+	for row := 0; row < c.depth; row++ {
+		mixed := base ^ (uint64(row+1) * 0x9E3779B97F4A7C15)
+		idx[row] = int(mixed % uint64(c.width))
+	}
+	return idx
+}
+
+// heavyHitter tracks an approximate frequency for one distinct value, as
+// maintained by a topKHeap.
+type heavyHitter struct {
+	Value string
+	Count uint32
+}
+
+// topKHeap is a bounded min-heap of heavyHitters, keyed by Count, used to
+// track the K most frequent values seen by a countMinSketch without
+// retaining every distinct value.
+type topKHeap struct {
+	cap   int
+	items []heavyHitter
+	index map[string]int // Value -> position in items, for O(log k) updates
+}
+
+func newTopKHeap(capacity int) *topKHeap {
+	return &topKHeap{cap: capacity, index: make(map[string]int)}
+}
+
+// Observe records that value now has the given estimated count, inserting
+// it into the heap (evicting the current minimum if already full) or
+// updating its existing entry.
+func (h *topKHeap) Observe(value string, count uint32) {
+	if pos, ok := h.index[value]; ok {
+		h.items[pos].Count = count
+		heap.Fix(h, pos)
+		return
+	}
+
+	if len(h.items) < h.cap {
+		heap.Push(h, heavyHitter{Value: value, Count: count})
+		return
+	}
+
+	if count > h.items[0].Count {
+		delete(h.index, h.items[0].Value)
+		h.items[0] = heavyHitter{Value: value, Count: count}
+		h.index[value] = 0
+		heap.Fix(h, 0)
+	}
+}
+
+// Top returns the tracked values ordered from most to least frequent.
+func (h *topKHeap) Top() []heavyHitter {
+	out := append([]heavyHitter(nil), h.items...)
+	for i := 1; i < len(out); i++ {
+		for j := i; j > 0 && out[j-1].Count < out[j].Count; j-- {
+			out[j-1], out[j] = out[j], out[j-1]
+		}
+	}
+	return out
+}
+
+func (h *topKHeap) Len() int { return len(h.items) }
+func (h *topKHeap) Less(i, j int) bool {
+	return h.items[i].Count < h.items[j].Count
+}
+func (h *topKHeap) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.index[h.items[i].Value] = i
+	h.index[h.items[j].Value] = j
+}
+func (h *topKHeap) Push(x interface{}) {
+	hh := x.(heavyHitter)
+	h.index[hh.Value] = len(h.items)
+	h.items = append(h.items, hh)
+}
+func (h *topKHeap) Pop() interface{} {
+	n := len(h.items)
+	hh := h.items[n-1]
+	h.items = h.items[:n-1]
+	delete(h.index, hh.Value)
+	return hh
+}
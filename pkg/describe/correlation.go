@@ -0,0 +1,132 @@
+package describe
+
+import (
+	"math"
+	"sort"
+)
+
+// CorrelationMatrix is a square matrix of pairwise correlation coefficients
+// over Columns, indexed the same way on both axes (Matrix[i][j] is the
+// correlation between Columns[i] and Columns[j]).
+type CorrelationMatrix struct {
+	Columns []string
+	Matrix  [][]float64
+}
+
+// Correlation returns the Pearson correlation matrix over df's numeric
+// columns. Columns with fewer than two rows are skipped.
+func (df *DataFrame) Correlation() CorrelationMatrix {
+	return df.correlation(pearson)
+}
+
+// SpearmanCorrelation returns the Spearman rank correlation matrix over
+// df's numeric columns: the Pearson correlation of each column's values
+// after replacing them with their ranks.
+func (df *DataFrame) SpearmanCorrelation() CorrelationMatrix {
+	return df.correlation(spearman)
+}
+
+func (df *DataFrame) correlation(coefficient func(x, y []float64) float64) CorrelationMatrix {
+	var columns []string
+	for name, vals := range df.Numeric {
+		if len(vals) > 1 {
+			columns = append(columns, name)
+		}
+	}
+	sort.Strings(columns)
+
+	matrix := make([][]float64, len(columns))
+	for i, colA := range columns {
+		matrix[i] = make([]float64, len(columns))
+		for j, colB := range columns {
+			a, b := df.alignedNumericPair(colA, colB)
+			matrix[i][j] = coefficient(a, b)
+		}
+	}
+
+	return CorrelationMatrix{Columns: columns, Matrix: matrix}
+}
+
+// alignedNumericPair returns the values of numeric columns colA and colB
+// restricted to the rows present in both, since each numeric column drops
+// its missing rows independently and so two columns of the same length
+// aren't necessarily row-aligned.
+func (df *DataFrame) alignedNumericPair(colA, colB string) (a, b []float64) {
+	valsA, valsB := df.Numeric[colA], df.Numeric[colB]
+	rowsA, rowsB := df.numericRowIndices(colA), df.numericRowIndices(colB)
+
+	byRow := make(map[int]float64, len(rowsB))
+	for i, row := range rowsB {
+		byRow[row] = valsB[i]
+	}
+
+	for i, row := range rowsA {
+		if v, ok := byRow[row]; ok {
+			a = append(a, valsA[i])
+			b = append(b, v)
+		}
+	}
+	return a, b
+}
+
+// pearson returns the Pearson product-moment correlation coefficient
+// between x and y, which must be the same length.
+func pearson(x, y []float64) float64 {
+	n := len(x)
+	if n == 0 || n != len(y) {
+		return math.NaN()
+	}
+
+	var sumX, sumY float64
+	for i := 0; i < n; i++ {
+		sumX += x[i]
+		sumY += y[i]
+	}
+	meanX, meanY := sumX/float64(n), sumY/float64(n)
+
+	var cov, varX, varY float64
+	for i := 0; i < n; i++ {
+		dx, dy := x[i]-meanX, y[i]-meanY
+		cov += dx * dy
+		varX += dx * dx
+		varY += dy * dy
+	}
+
+	if varX == 0 || varY == 0 {
+		return math.NaN()
+	}
+	return cov / math.Sqrt(varX*varY)
+}
+
+// spearman returns the Spearman rank correlation between x and y: the
+// Pearson correlation of their ranks, with tied values given the average
+// rank of the positions they span.
+func spearman(x, y []float64) float64 {
+	if len(x) != len(y) {
+		return math.NaN()
+	}
+	return pearson(rank(x), rank(y))
+}
+
+func rank(vals []float64) []float64 {
+	idx := make([]int, len(vals))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(i, j int) bool { return vals[idx[i]] < vals[idx[j]] })
+
+	ranks := make([]float64, len(vals))
+	i := 0
+	for i < len(idx) {
+		j := i
+		for j+1 < len(idx) && vals[idx[j+1]] == vals[idx[i]] {
+			j++
+		}
+		avgRank := float64(i+j)/2 + 1
+		for k := i; k <= j; k++ {
+			ranks[idx[k]] = avgRank
+		}
+		i = j + 1
+	}
+	return ranks
+}
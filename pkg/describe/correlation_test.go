@@ -0,0 +1,112 @@
+package describe
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestCorrelationPerfectlyCorrelated(t *testing.T) {
+	df := &DataFrame{
+		Numeric: map[string][]float64{
+			"x": {1, 2, 3, 4, 5},
+			"y": {2, 4, 6, 8, 10},
+		},
+	}
+
+	matrix := df.Correlation()
+	r := valueAt(matrix, "x", "y")
+	if math.Abs(r-1) > 1e-9 {
+		t.Errorf("Pearson correlation = %v, want 1", r)
+	}
+}
+
+func TestChiSquareIndependentColumns(t *testing.T) {
+	df := &DataFrame{
+		Categorical: map[string][]string{
+			"a": {"x", "x", "y", "y", "x", "x", "y", "y"},
+			"b": {"p", "q", "p", "q", "p", "q", "p", "q"},
+		},
+	}
+
+	result, err := df.ChiSquare("a", "b")
+	if err != nil {
+		t.Fatalf("ChiSquare returned error: %v", err)
+	}
+	if result.DOF != 1 {
+		t.Errorf("dof = %d, want 1", result.DOF)
+	}
+	if result.PValue < 0 || result.PValue > 1 {
+		t.Errorf("p-value = %v, expected a value in [0, 1]", result.PValue)
+	}
+}
+
+func TestTTestDifferentMeans(t *testing.T) {
+	df := &DataFrame{
+		Numeric: map[string][]float64{
+			"score": {1, 2, 3, 10, 11, 12},
+		},
+		Categorical: map[string][]string{
+			"group": {"a", "a", "a", "b", "b", "b"},
+		},
+	}
+
+	result, err := df.TTest("score", "group")
+	if err != nil {
+		t.Fatalf("TTest returned error: %v", err)
+	}
+	if result.PValue > 0.05 {
+		t.Errorf("p-value = %v, expected a clearly significant difference", result.PValue)
+	}
+}
+
+func TestTTestAlignsRowsWithDroppedNumericValues(t *testing.T) {
+	// "score" drops its own missing row (row 1), so df.Numeric["score"] is
+	// shorter than df.Categorical["group"]. TTest must still match each
+	// retained score to the group at its original row, not by position.
+	csvData := "score,group\n1,a\nNA,a\n2,a\n10,b\n11,b\n12,b\n"
+	df, err := ReadCSV(strings.NewReader(csvData), DefaultOptions())
+	if err != nil {
+		t.Fatalf("ReadCSV returned error: %v", err)
+	}
+
+	result, err := df.TTest("score", "group")
+	if err != nil {
+		t.Fatalf("TTest returned error: %v", err)
+	}
+	if result.PValue > 0.05 {
+		t.Errorf("p-value = %v, expected a clearly significant difference", result.PValue)
+	}
+}
+
+func TestCorrelationIgnoresRowsMissingInEitherColumn(t *testing.T) {
+	// x drops row 3 (NA), y drops row 1 (NA), so only rows 0 and 2 are
+	// present in both: (x=1,y=100) and (x=3,y=300), which are perfectly
+	// correlated (r=1). Pairing by position instead of by row, as the old
+	// code did, would mix in the unrelated values at row 3 of x's output
+	// with row 1 of y's, giving a different (and wrong) coefficient.
+	csvData := "x,y\n1,100\n2,NA\n3,300\nNA,400\n"
+	df, err := ReadCSV(strings.NewReader(csvData), DefaultOptions())
+	if err != nil {
+		t.Fatalf("ReadCSV returned error: %v", err)
+	}
+
+	matrix := df.Correlation()
+	r := valueAt(matrix, "x", "y")
+	if math.Abs(r-1) > 1e-9 {
+		t.Errorf("Pearson correlation = %v, want 1 (only rows 0 and 2 are present in both columns)", r)
+	}
+}
+
+func valueAt(m CorrelationMatrix, colA, colB string) float64 {
+	var i, j int
+	for k, c := range m.Columns {
+		if c == colA {
+			i = k
+		}
+		if c == colB {
+			j = k
+		}
+	}
+	return m.Matrix[i][j]
+}
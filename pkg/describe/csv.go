@@ -0,0 +1,185 @@
+package describe
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+)
+
+// ReadCSV parses CSV data from r into a DataFrame using opts. Each column
+// header becomes a key in the resulting DataFrame, with the column's
+// ColumnKind decided by inferColumnKind (or an Options.ColumnTypes
+// override) and its values routed to the matching Numeric/Bool/Date/
+// Categorical map.
+func ReadCSV(r io.Reader, opts Options) (*DataFrame, error) {
+	r, err := decodeReader(r, opts.Encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, headers, err := parseCSVToMap(r, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return classifyColumns(raw, headers, opts), nil
+}
+
+// parseCSVToMap reads r using the given opts and collects each column's raw
+// string values, keyed by header name.
+func parseCSVToMap(r io.Reader, opts Options) (map[string][]string, []string, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = opts.Separator
+	reader.TrimLeadingSpace = true
+
+	var headers []string
+	result := make(map[string][]string)
+
+	// Read the first row to determine headers
+	firstRow, err := reader.Read()
+	if err == io.EOF {
+		return result, headers, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opts.HasHeader {
+		headers = firstRow
+	} else {
+		headers = make([]string, len(firstRow))
+		for i := range firstRow {
+			headers[i] = "col" + strconv.Itoa(i+1)
+		}
+		// Reuse the first row as data
+		for i, val := range firstRow {
+			result[headers[i]] = []string{val}
+		}
+	}
+
+	// Initialize empty slices for headers if not already filled
+	for _, h := range headers {
+		if _, ok := result[h]; !ok {
+			result[h] = []string{}
+		}
+	}
+
+	// Read and process the rest of the rows
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for i, val := range record {
+			if i < len(headers) {
+				result[headers[i]] = append(result[headers[i]], val)
+			}
+		}
+	}
+
+	return result, headers, nil
+}
+
+// classifyColumns infers a ColumnKind for each column in data (honoring
+// Options.ColumnTypes overrides) and converts its values accordingly.
+// Values that don't match the column's kind, as well as missing values,
+// are dropped from typed columns and recorded as "NA" in categorical ones.
+func classifyColumns(data map[string][]string, headers []string, opts Options) *DataFrame {
+	df := &DataFrame{
+		Headers:      headers,
+		Numeric:      make(map[string][]float64),
+		Bool:         make(map[string][]bool),
+		Date:         make(map[string][]time.Time),
+		Categorical:  make(map[string][]string),
+		Kinds:        make(map[string]ColumnKind),
+		MissingStats: make(map[string]MissingStats),
+		NumericRows:  make(map[string][]int),
+	}
+
+	for key, values := range data {
+		kind, ok := opts.ColumnTypes[key]
+		if !ok {
+			kind = inferColumnKind(values, opts)
+		}
+		df.Kinds[key] = kind
+
+		missing := newMissingStatsCollector(len(values))
+
+		switch kind {
+		case KindInt, KindFloat:
+			var converted []float64
+			var rows []int
+			for i, v := range values {
+				if isMissing(v, opts) {
+					missing.Missing(v)
+					continue
+				}
+				var f float64
+				var ok bool
+				if kind == KindInt {
+					var n int64
+					n, ok = parseInt(v)
+					f = float64(n)
+				} else {
+					f, ok = parseNumericLike(v)
+				}
+				if ok {
+					converted = append(converted, f)
+					rows = append(rows, i)
+				} else {
+					missing.Missing(v)
+				}
+			}
+			df.Numeric[key] = converted
+			df.NumericRows[key] = rows
+		case KindBool:
+			var converted []bool
+			for _, v := range values {
+				if isMissing(v, opts) {
+					missing.Missing(v)
+					continue
+				}
+				if b, ok := parseBool(v); ok {
+					converted = append(converted, b)
+				} else {
+					missing.Missing(v)
+				}
+			}
+			df.Bool[key] = converted
+		case KindDate:
+			var converted []time.Time
+			for _, v := range values {
+				if isMissing(v, opts) {
+					missing.Missing(v)
+					continue
+				}
+				if t, ok := parseDate(v); ok {
+					converted = append(converted, t)
+				} else {
+					missing.Missing(v)
+				}
+			}
+			df.Date[key] = converted
+		default:
+			categorical := make([]string, len(values))
+			for i, v := range values {
+				if isMissing(v, opts) {
+					categorical[i] = "NA"
+					missing.Missing(v)
+				} else {
+					categorical[i] = v
+				}
+			}
+			df.Categorical[key] = categorical
+		}
+
+		df.MissingStats[key] = missing.Stats()
+	}
+
+	return df
+}
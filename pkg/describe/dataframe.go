@@ -0,0 +1,56 @@
+package describe
+
+import (
+	"strings"
+	"time"
+)
+
+// DataFrame is a columnar, in-memory view of a parsed CSV source. Each
+// column is classified by inferColumnKind (or an Options.ColumnTypes
+// override) into exactly one of Numeric (ints and floats alike),
+// Bool, Date, or Categorical. Kinds records the inferred ColumnKind per
+// column, which Numeric alone can't distinguish (int vs float).
+type DataFrame struct {
+	Headers      []string
+	Numeric      map[string][]float64
+	Bool         map[string][]bool
+	Date         map[string][]time.Time
+	Categorical  map[string][]string
+	Kinds        map[string]ColumnKind
+	MissingStats map[string]MissingStats
+
+	// NumericRows records, for each Numeric column, the original CSV row
+	// index that each retained value came from. Missing/unparseable rows
+	// are dropped independently per numeric column, so two numeric
+	// columns of the same length aren't necessarily row-aligned; this map
+	// lets cross-column operations (Correlation, TTest) recover which
+	// values actually came from the same row.
+	NumericRows map[string][]int
+}
+
+// numericRowIndices returns the original-row index for each value in
+// df.Numeric[col]. It falls back to positional indices (0..n-1) when
+// NumericRows has no entry for col, which happens for a DataFrame built
+// by hand (e.g. in tests) rather than via ReadCSV/classifyColumns.
+func (df *DataFrame) numericRowIndices(col string) []int {
+	if rows, ok := df.NumericRows[col]; ok {
+		return rows
+	}
+	vals := df.Numeric[col]
+	rows := make([]int, len(vals))
+	for i := range rows {
+		rows[i] = i
+	}
+	return rows
+}
+
+// isMissing reports whether val should be treated as NA under opts.
+func isMissing(val string, opts Options) bool {
+	val = strings.TrimSpace(strings.ToLower(val))
+	for _, m := range opts.MissingVals {
+		if val == strings.ToLower(m) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package describe
+
+import (
+	"bufio"
+	"io"
+
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+)
+
+// decodeReader wraps r with a decoder for opts.Encoding, transcoding it to
+// UTF-8 before it ever reaches the CSV parser. EncodingAuto (and the zero
+// value) sniff a byte-order mark and otherwise assume UTF-8; detecting GBK
+// or Latin-1 without a BOM needs an explicit Encoding, since byte-level
+// charset sniffing is unreliable on short or numeric-heavy CSVs.
+func decodeReader(r io.Reader, enc Encoding) (io.Reader, error) {
+	switch enc {
+	case "", EncodingAuto:
+		return autoDecodeReader(r)
+	case EncodingUTF8:
+		return r, nil
+	case EncodingUTF16LE:
+		return transform.NewReader(r, unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM).NewDecoder()), nil
+	case EncodingUTF16BE:
+		return transform.NewReader(r, unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM).NewDecoder()), nil
+	case EncodingGBK:
+		return transform.NewReader(r, simplifiedchinese.GBK.NewDecoder()), nil
+	case EncodingLatin1:
+		return transform.NewReader(r, charmap.ISO8859_1.NewDecoder()), nil
+	default:
+		return nil, &unknownEncodingError{enc}
+	}
+}
+
+// autoDecodeReader sniffs a leading byte-order mark from r and returns a
+// reader transcoding accordingly; absent a BOM it assumes UTF-8.
+func autoDecodeReader(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	bom, err := br.Peek(3)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	switch {
+	case len(bom) >= 2 && bom[0] == 0xFF && bom[1] == 0xFE:
+		br.Discard(2)
+		return transform.NewReader(br, unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM).NewDecoder()), nil
+	case len(bom) >= 2 && bom[0] == 0xFE && bom[1] == 0xFF:
+		br.Discard(2)
+		return transform.NewReader(br, unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM).NewDecoder()), nil
+	case len(bom) >= 3 && bom[0] == 0xEF && bom[1] == 0xBB && bom[2] == 0xBF:
+		br.Discard(3)
+		return br, nil
+	default:
+		return br, nil
+	}
+}
+
+type unknownEncodingError struct {
+	encoding Encoding
+}
+
+func (e *unknownEncodingError) Error() string {
+	return "describe: unknown encoding " + string(e.encoding)
+}
@@ -0,0 +1,41 @@
+package describe
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+func TestReadCSVAutoDetectsUTF16LEBOM(t *testing.T) {
+	data := []byte{0xFF, 0xFE}
+	for _, r := range "name,age\nalice,30\n" {
+		data = append(data, byte(r), 0)
+	}
+
+	opts := DefaultOptions()
+	df, err := ReadCSV(strings.NewReader(string(data)), opts)
+	if err != nil {
+		t.Fatalf("ReadCSV returned error: %v", err)
+	}
+	if got := df.Categorical["name"]; len(got) != 1 || got[0] != "alice" {
+		t.Errorf("name column = %v, want [alice]", got)
+	}
+}
+
+func TestReadCSVExplicitGBK(t *testing.T) {
+	encoded, err := simplifiedchinese.GBK.NewEncoder().String("name\n北京\n")
+	if err != nil {
+		t.Fatalf("failed to encode fixture: %v", err)
+	}
+
+	opts := DefaultOptions()
+	opts.Encoding = EncodingGBK
+	df, err := ReadCSV(strings.NewReader(encoded), opts)
+	if err != nil {
+		t.Fatalf("ReadCSV returned error: %v", err)
+	}
+	if got := df.Categorical["name"]; len(got) != 1 || got[0] != "北京" {
+		t.Errorf("name column = %v, want [北京]", got)
+	}
+}
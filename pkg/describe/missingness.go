@@ -0,0 +1,58 @@
+package describe
+
+// MissingStats records how many of a column's raw values were dropped
+// during parsing, either because they matched Options.MissingVals or
+// because they failed to parse as the column's inferred ColumnKind.
+type MissingStats struct {
+	Count    int
+	Fraction float64
+	Examples []string // a handful of distinct offending raw values, for diagnostics
+}
+
+// maxMissingExamples caps how many distinct offending values MissingStats
+// keeps per column, so a column with many distinct garbage values doesn't
+// bloat the report.
+const maxMissingExamples = 3
+
+// missingStatsCollector accumulates MissingStats for a single column while
+// its raw values are being classified.
+type missingStatsCollector struct {
+	total    int
+	missing  int
+	examples []string
+	seen     map[string]bool
+}
+
+func newMissingStatsCollector(total int) *missingStatsCollector {
+	return &missingStatsCollector{total: total, seen: make(map[string]bool)}
+}
+
+// Missing records val as dropped from the typed column, either because it
+// matched Options.MissingVals or failed to parse as the column's kind.
+func (c *missingStatsCollector) Missing(val string) {
+	c.missing++
+	if len(c.examples) < maxMissingExamples && !c.seen[val] {
+		c.seen[val] = true
+		c.examples = append(c.examples, val)
+	}
+}
+
+func (c *missingStatsCollector) Stats() MissingStats {
+	var fraction float64
+	if c.total > 0 {
+		fraction = float64(c.missing) / float64(c.total)
+	}
+	return MissingStats{
+		Count:    c.missing,
+		Fraction: fraction,
+		Examples: c.examples,
+	}
+}
+
+// MissingnessReport is a top-level summary of missing-value distribution
+// across every column in a DataFrame, keyed by column name in Headers
+// order.
+type MissingnessReport struct {
+	Columns []string
+	Stats   map[string]MissingStats
+}
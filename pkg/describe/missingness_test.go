@@ -0,0 +1,54 @@
+package describe
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestReadCSVTracksMissingStats(t *testing.T) {
+	csvData := "age,city\n20,paris\n,berlin\nnot-a-number,lyon\n30,\n"
+
+	opts := DefaultOptions()
+	opts.MissingThreshold = 0.5
+	df, err := ReadCSV(strings.NewReader(csvData), opts)
+	if err != nil {
+		t.Fatalf("ReadCSV returned error: %v", err)
+	}
+
+	ageStats := df.MissingStats["age"]
+	if ageStats.Count != 2 {
+		t.Errorf("age missing count = %d, want 2", ageStats.Count)
+	}
+	if ageStats.Fraction != 0.5 {
+		t.Errorf("age missing fraction = %v, want 0.5", ageStats.Fraction)
+	}
+
+	cityStats := df.MissingStats["city"]
+	if cityStats.Count != 1 {
+		t.Errorf("city missing count = %d, want 1", cityStats.Count)
+	}
+}
+
+func TestRenderIncludesMissingnessMatrix(t *testing.T) {
+	df := &DataFrame{
+		Headers: []string{"age"},
+		Numeric: map[string][]float64{"age": {20, 30}},
+		MissingStats: map[string]MissingStats{
+			"age": {Count: 1, Fraction: 0.33, Examples: []string{"n/a"}},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := Render(&buf, TextReporter{}, df.Describe()); err != nil {
+		t.Fatalf("Render returned error: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "Missingness matrix:") {
+		t.Errorf("expected a missingness matrix section, got: %s", out)
+	}
+	if !strings.Contains(out, "n/a") {
+		t.Errorf("expected example offending value in output, got: %s", out)
+	}
+}
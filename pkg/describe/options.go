@@ -0,0 +1,42 @@
+package describe
+
+// Encoding identifies the character encoding of a CSV source.
+type Encoding string
+
+const (
+	EncodingAuto    Encoding = "auto" // sniff a BOM, defaulting to UTF-8 if none is found
+	EncodingUTF8    Encoding = "utf8"
+	EncodingUTF16LE Encoding = "utf16le"
+	EncodingUTF16BE Encoding = "utf16be"
+	EncodingGBK     Encoding = "gbk"
+	EncodingLatin1  Encoding = "latin1"
+)
+
+// Options configures how a CSV source is parsed into a DataFrame.
+type Options struct {
+	Separator   rune     // Field delimiter
+	HasHeader   bool     // Whether to treat the first row as column headers
+	MissingVals []string // Values treated as missing/NA (case-insensitive)
+	Encoding    Encoding // Source character encoding; "" behaves like EncodingAuto
+
+	// MissingThreshold is the fraction of a column's non-missing values
+	// that may fail to match a candidate ColumnKind (int, float, bool,
+	// date) while the column is still inferred as that kind; the failing
+	// values are then treated as missing. 0 requires every value to match.
+	MissingThreshold float64
+
+	// ColumnTypes overrides kind inference for specific columns by name.
+	ColumnTypes map[string]ColumnKind
+}
+
+// DefaultOptions returns the Options used by the CLI when none are given:
+// comma-separated, headered CSV with the common set of NA spellings,
+// auto-detected encoding, and strict (zero-tolerance) type inference.
+func DefaultOptions() Options {
+	return Options{
+		Separator:   ',',
+		HasHeader:   true,
+		MissingVals: []string{"", "na", "n/a", "null", "missing"},
+		Encoding:    EncodingAuto,
+	}
+}
@@ -0,0 +1,116 @@
+package describe
+
+import "sort"
+
+// p2Estimator implements the P² ("Piecewise-Parabolic") algorithm (Jain &
+// Chlamtac, 1985) for estimating a single quantile from a stream of values
+// in O(1) memory. It is accurate to a few percent for smooth
+// distributions and is used by streamNumericAccumulator to approximate
+// Q25/Q50/Q75 without retaining the underlying values.
+type p2Estimator struct {
+	p float64
+
+	initial []float64 // buffers the first 5 observations before markers are seeded
+
+	n        [5]float64 // marker positions
+	nDesired [5]float64 // desired marker positions
+	dn       [5]float64 // increment per observation for the desired position
+	q        [5]float64 // marker heights (the estimate lives in q[2])
+}
+
+func newP2Estimator(p float64) *p2Estimator {
+	return &p2Estimator{p: p}
+}
+
+// Add feeds a new observation into the estimator.
+func (e *p2Estimator) Add(x float64) {
+	if len(e.initial) < 5 {
+		e.initial = append(e.initial, x)
+		if len(e.initial) == 5 {
+			e.seed()
+		}
+		return
+	}
+
+	var k int
+	switch {
+	case x < e.q[0]:
+		e.q[0] = x
+		k = 0
+	case x < e.q[1]:
+		k = 0
+	case x < e.q[2]:
+		k = 1
+	case x < e.q[3]:
+		k = 2
+	case x <= e.q[4]:
+		k = 3
+	default:
+		e.q[4] = x
+		k = 3
+	}
+
+	for i := k + 1; i < 5; i++ {
+		e.n[i]++
+	}
+	for i := range e.nDesired {
+		e.nDesired[i] += e.dn[i]
+	}
+
+	for i := 1; i <= 3; i++ {
+		d := e.nDesired[i] - e.n[i]
+		if (d >= 1 && e.n[i+1]-e.n[i] > 1) || (d <= -1 && e.n[i-1]-e.n[i] < -1) {
+			sign := 1.0
+			if d < 0 {
+				sign = -1.0
+			}
+			qNew := e.parabolic(i, sign)
+			if e.q[i-1] < qNew && qNew < e.q[i+1] {
+				e.q[i] = qNew
+			} else {
+				e.q[i] = e.linear(i, sign)
+			}
+			e.n[i] += sign
+		}
+	}
+}
+
+func (e *p2Estimator) parabolic(i int, d float64) float64 {
+	return e.q[i] + d/(e.n[i+1]-e.n[i-1])*((e.n[i]-e.n[i-1]+d)*(e.q[i+1]-e.q[i])/(e.n[i+1]-e.n[i])+
+		(e.n[i+1]-e.n[i]-d)*(e.q[i]-e.q[i-1])/(e.n[i]-e.n[i-1]))
+}
+
+func (e *p2Estimator) linear(i int, d float64) float64 {
+	return e.q[i] + d*(e.q[int(float64(i)+d)]-e.q[i])/(e.n[int(float64(i)+d)]-e.n[i])
+}
+
+// seed initializes the five markers from the first five buffered
+// observations once they have arrived.
+func (e *p2Estimator) seed() {
+	sort.Float64s(e.initial)
+	for i := 0; i < 5; i++ {
+		e.q[i] = e.initial[i]
+		e.n[i] = float64(i + 1)
+	}
+	p := e.p
+	e.nDesired = [5]float64{1, 1 + 2*p, 1 + 4*p, 3 + 2*p, 5}
+	e.dn = [5]float64{0, p / 2, p, (1 + p) / 2, 1}
+}
+
+// Value returns the current quantile estimate. Until 5 observations have
+// been seen it falls back to the exact value from the buffered sample.
+func (e *p2Estimator) Value() float64 {
+	if len(e.initial) < 5 {
+		if len(e.initial) == 0 {
+			return 0
+		}
+		sorted := append([]float64(nil), e.initial...)
+		sort.Float64s(sorted)
+		idx := int(e.p * float64(len(sorted)))
+		if idx >= len(sorted) {
+			idx = len(sorted) - 1
+		}
+		return sorted[idx]
+	}
+	return e.q[2]
+}
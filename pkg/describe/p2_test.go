@@ -0,0 +1,18 @@
+package describe
+
+import (
+	"math"
+	"testing"
+)
+
+func TestP2EstimatorApproximatesMedian(t *testing.T) {
+	e := newP2Estimator(0.5)
+	for i := 1; i <= 1000; i++ {
+		e.Add(float64(i))
+	}
+	got := e.Value()
+	want := 500.5
+	if math.Abs(got-want) > 25 {
+		t.Errorf("median estimate = %.2f, want close to %.2f", got, want)
+	}
+}
@@ -0,0 +1,115 @@
+package describe
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Reporter renders a Report to w in some output format. Implementations are
+// responsible for rendering every section of report, including Missingness,
+// in a way that keeps their own format well-formed (e.g. as part of a JSON
+// struct or an extra table), rather than relying on a caller to splice in a
+// separate text block afterwards.
+type Reporter interface {
+	Render(w io.Writer, report Report) error
+}
+
+// ReporterFor returns the Reporter registered under format ("text", "json",
+// "csv", "markdown" or "html"). The csv Reporter uses separator as its
+// field delimiter.
+func ReporterFor(format string, separator rune) (Reporter, error) {
+	switch format {
+	case "", "text":
+		return TextReporter{}, nil
+	case "json":
+		return JSONReporter{}, nil
+	case "csv":
+		return CSVReporter{Separator: separator}, nil
+	case "markdown", "md":
+		return MarkdownReporter{}, nil
+	case "html":
+		return HTMLReporter{}, nil
+	default:
+		return nil, fmt.Errorf("describe: unknown report format %q", format)
+	}
+}
+
+// Render writes report using rep. It's a thin pass-through kept so callers
+// don't need to know whether rep came from ReporterFor or was constructed
+// directly.
+func Render(w io.Writer, rep Reporter, report Report) error {
+	return rep.Render(w, report)
+}
+
+// renderMissingnessMatrix prints a column-by-column breakdown of dropped
+// (missing or unparseable) values.
+func renderMissingnessMatrix(w io.Writer, report MissingnessReport) error {
+	if len(report.Columns) == 0 {
+		return nil
+	}
+
+	if _, err := fmt.Fprintln(w, "Missingness matrix:"); err != nil {
+		return err
+	}
+	for _, col := range report.Columns {
+		stats := report.Stats[col]
+		if _, err := fmt.Fprintf(w, "  %-20s %6d missing (%.1f%%)", col, stats.Count, stats.Fraction*100); err != nil {
+			return err
+		}
+		if len(stats.Examples) > 0 {
+			fmt.Fprintf(w, "  e.g. %s", strings.Join(stats.Examples, ", "))
+		}
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+// TextReporter renders summaries as the CLI's original plain-text tables,
+// followed by a missingness matrix section.
+type TextReporter struct{}
+
+func (TextReporter) Render(w io.Writer, report Report) error {
+	for _, s := range report.Categorical {
+		if _, err := fmt.Fprintf(w, "Column:       %s\n", s.Name); err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "Uniques:      %d\n", s.UniqueCount)
+		fmt.Fprintf(w, "Mode:         %s\n", s.Mode)
+		fmt.Fprintf(w, "Top Frequent: %s\n", strings.Join(s.TopFrequent, ", "))
+		fmt.Fprintf(w, "Missing:      %d (%.1f%%)\n", s.Missing.Count, s.Missing.Fraction*100)
+		fmt.Fprintln(w)
+	}
+
+	for _, s := range report.Numeric {
+		fmt.Fprintf(w, "Column:   %s\n", s.Name)
+		fmt.Fprintf(w, "Mean:     %.2f\n", s.Mean)
+		fmt.Fprintf(w, "StdDev:   %.2f\n", s.StdDev)
+		fmt.Fprintf(w, "Min:      %.2f\n", s.Min)
+		fmt.Fprintf(w, "25%%:      %.2f\n", s.Q25)
+		fmt.Fprintf(w, "50%%:      %.2f\n", s.Q50)
+		fmt.Fprintf(w, "75%%:      %.2f\n", s.Q75)
+		fmt.Fprintf(w, "Max:      %.2f\n", s.Max)
+		fmt.Fprintf(w, "Missing:  %d (%.1f%%)\n", s.Missing.Count, s.Missing.Fraction*100)
+		fmt.Fprintln(w)
+	}
+
+	for _, s := range report.Bool {
+		fmt.Fprintf(w, "Column: %s\n", s.Name)
+		fmt.Fprintf(w, "True:   %d\n", s.TrueCount)
+		fmt.Fprintf(w, "False:  %d\n", s.FalseCount)
+		fmt.Fprintln(w)
+	}
+
+	for _, s := range report.Date {
+		fmt.Fprintf(w, "Column:         %s\n", s.Name)
+		fmt.Fprintf(w, "Min:            %s\n", s.Min.Format(time.RFC3339))
+		fmt.Fprintf(w, "Max:            %s\n", s.Max.Format(time.RFC3339))
+		fmt.Fprintf(w, "Range:          %s\n", s.Range)
+		fmt.Fprintf(w, "Common Weekday: %s\n", s.CommonWeekday)
+		fmt.Fprintln(w)
+	}
+
+	return renderMissingnessMatrix(w, report.Missingness)
+}
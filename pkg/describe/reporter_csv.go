@@ -0,0 +1,136 @@
+package describe
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CSVReporter renders summaries as five CSV tables (numeric, categorical,
+// bool, date, then missingness), separated by a blank line, using Separator
+// as the field delimiter.
+type CSVReporter struct {
+	Separator rune
+}
+
+func (r CSVReporter) Render(w io.Writer, report Report) error {
+	sep := r.Separator
+	if sep == 0 {
+		sep = ','
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Comma = sep
+
+	if err := cw.Write([]string{"column", "mean", "stddev", "min", "q25", "q50", "q75", "max"}); err != nil {
+		return err
+	}
+	for _, s := range report.Numeric {
+		row := []string{
+			s.Name,
+			formatFloat(s.Mean),
+			formatFloat(s.StdDev),
+			formatFloat(s.Min),
+			formatFloat(s.Q25),
+			formatFloat(s.Q50),
+			formatFloat(s.Q75),
+			formatFloat(s.Max),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+
+	if err := cw.Write([]string{"column", "uniques", "mode", "top_frequent"}); err != nil {
+		return err
+	}
+	for _, s := range report.Categorical {
+		row := []string{
+			s.Name,
+			strconv.Itoa(s.UniqueCount),
+			s.Mode,
+			strings.Join(s.TopFrequent, "|"),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+
+	if err := cw.Write([]string{"column", "true_count", "false_count"}); err != nil {
+		return err
+	}
+	for _, s := range report.Bool {
+		row := []string{s.Name, strconv.Itoa(s.TrueCount), strconv.Itoa(s.FalseCount)}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+
+	if err := cw.Write([]string{"column", "min", "max", "range", "common_weekday"}); err != nil {
+		return err
+	}
+	for _, s := range report.Date {
+		row := []string{
+			s.Name,
+			s.Min.Format(time.RFC3339),
+			s.Max.Format(time.RFC3339),
+			s.Range.String(),
+			s.CommonWeekday.String(),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+
+	if err := cw.Write([]string{"column", "missing_count", "missing_fraction"}); err != nil {
+		return err
+	}
+	for _, col := range report.Missingness.Columns {
+		stats := report.Missingness.Stats[col]
+		row := []string{col, strconv.Itoa(stats.Count), formatFloat(stats.Fraction)}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', 2, 64)
+}
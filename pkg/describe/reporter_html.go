@@ -0,0 +1,64 @@
+package describe
+
+import (
+	"html/template"
+	"io"
+	"strings"
+)
+
+// HTMLReporter renders summaries as a standalone HTML page containing one
+// table per column type, plus a missingness table.
+type HTMLReporter struct{}
+
+func (HTMLReporter) Render(w io.Writer, report Report) error {
+	return htmlReportTemplate.Execute(w, struct {
+		Numeric     []numericSummary
+		Categorical []categoricalSummary
+		Bool        []boolSummary
+		Date        []dateSummary
+		Missingness MissingnessReport
+	}{report.Numeric, report.Categorical, report.Bool, report.Date, report.Missingness})
+}
+
+var htmlReportTemplate = template.Must(template.New("report").
+	Funcs(template.FuncMap{"join": strings.Join}).
+	Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>describe report</title>
+<style>
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; }
+th { background: #f0f0f0; }
+</style>
+</head>
+<body>
+<h1>Numeric columns</h1>
+<table>
+<tr><th>Column</th><th>Mean</th><th>StdDev</th><th>Min</th><th>25%</th><th>50%</th><th>75%</th><th>Max</th></tr>
+{{range .Numeric}}<tr><td>{{.Name}}</td><td>{{printf "%.2f" .Mean}}</td><td>{{printf "%.2f" .StdDev}}</td><td>{{printf "%.2f" .Min}}</td><td>{{printf "%.2f" .Q25}}</td><td>{{printf "%.2f" .Q50}}</td><td>{{printf "%.2f" .Q75}}</td><td>{{printf "%.2f" .Max}}</td></tr>
+{{end}}</table>
+<h1>Categorical columns</h1>
+<table>
+<tr><th>Column</th><th>Uniques</th><th>Mode</th><th>Top Frequent</th></tr>
+{{range .Categorical}}<tr><td>{{.Name}}</td><td>{{.UniqueCount}}</td><td>{{.Mode}}</td><td>{{join .TopFrequent ", "}}</td></tr>
+{{end}}</table>
+<h1>Bool columns</h1>
+<table>
+<tr><th>Column</th><th>True</th><th>False</th></tr>
+{{range .Bool}}<tr><td>{{.Name}}</td><td>{{.TrueCount}}</td><td>{{.FalseCount}}</td></tr>
+{{end}}</table>
+<h1>Date columns</h1>
+<table>
+<tr><th>Column</th><th>Min</th><th>Max</th><th>Range</th><th>Common Weekday</th></tr>
+{{range .Date}}<tr><td>{{.Name}}</td><td>{{.Min.Format "2006-01-02T15:04:05Z07:00"}}</td><td>{{.Max.Format "2006-01-02T15:04:05Z07:00"}}</td><td>{{.Range}}</td><td>{{.CommonWeekday}}</td></tr>
+{{end}}</table>
+<h1>Missingness</h1>
+<table>
+<tr><th>Column</th><th>Missing</th><th>Fraction</th></tr>
+{{range .Missingness.Columns}}<tr><td>{{.}}</td><td>{{(index $.Missingness.Stats .).Count}}</td><td>{{printf "%.3f" (index $.Missingness.Stats .).Fraction}}</td></tr>
+{{end}}</table>
+</body>
+</html>
+`))
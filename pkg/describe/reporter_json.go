@@ -0,0 +1,22 @@
+package describe
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// JSONReporter renders summaries as a single JSON object with "numeric",
+// "categorical", "bool", "date" and "missingness" keys.
+type JSONReporter struct{}
+
+func (JSONReporter) Render(w io.Writer, report Report) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		Numeric     []numericSummary     `json:"numeric"`
+		Categorical []categoricalSummary `json:"categorical"`
+		Bool        []boolSummary        `json:"bool"`
+		Date        []dateSummary        `json:"date"`
+		Missingness MissingnessReport    `json:"missingness"`
+	}{report.Numeric, report.Categorical, report.Bool, report.Date, report.Missingness})
+}
@@ -0,0 +1,64 @@
+package describe
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// MarkdownReporter renders summaries as five Markdown tables (numeric,
+// categorical, bool, date, then missingness).
+type MarkdownReporter struct{}
+
+func (MarkdownReporter) Render(w io.Writer, report Report) error {
+	if len(report.Numeric) > 0 {
+		fmt.Fprintln(w, "| Column | Mean | StdDev | Min | 25% | 50% | 75% | Max |")
+		fmt.Fprintln(w, "|---|---|---|---|---|---|---|---|")
+		for _, s := range report.Numeric {
+			fmt.Fprintf(w, "| %s | %.2f | %.2f | %.2f | %.2f | %.2f | %.2f | %.2f |\n",
+				s.Name, s.Mean, s.StdDev, s.Min, s.Q25, s.Q50, s.Q75, s.Max)
+		}
+		fmt.Fprintln(w)
+	}
+
+	if len(report.Categorical) > 0 {
+		fmt.Fprintln(w, "| Column | Uniques | Mode | Top Frequent |")
+		fmt.Fprintln(w, "|---|---|---|---|")
+		for _, s := range report.Categorical {
+			fmt.Fprintf(w, "| %s | %d | %s | %s |\n",
+				s.Name, s.UniqueCount, s.Mode, strings.Join(s.TopFrequent, ", "))
+		}
+		fmt.Fprintln(w)
+	}
+
+	if len(report.Bool) > 0 {
+		fmt.Fprintln(w, "| Column | True | False |")
+		fmt.Fprintln(w, "|---|---|---|")
+		for _, s := range report.Bool {
+			fmt.Fprintf(w, "| %s | %d | %d |\n", s.Name, s.TrueCount, s.FalseCount)
+		}
+		fmt.Fprintln(w)
+	}
+
+	if len(report.Date) > 0 {
+		fmt.Fprintln(w, "| Column | Min | Max | Range | Common Weekday |")
+		fmt.Fprintln(w, "|---|---|---|---|---|")
+		for _, s := range report.Date {
+			fmt.Fprintf(w, "| %s | %s | %s | %s | %s |\n",
+				s.Name, s.Min.Format(time.RFC3339), s.Max.Format(time.RFC3339), s.Range, s.CommonWeekday)
+		}
+		fmt.Fprintln(w)
+	}
+
+	if len(report.Missingness.Columns) > 0 {
+		fmt.Fprintln(w, "| Column | Missing | Fraction |")
+		fmt.Fprintln(w, "|---|---|---|")
+		for _, col := range report.Missingness.Columns {
+			stats := report.Missingness.Stats[col]
+			fmt.Fprintf(w, "| %s | %d | %.3f |\n", col, stats.Count, stats.Fraction)
+		}
+	}
+
+	return nil
+}
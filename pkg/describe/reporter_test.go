@@ -0,0 +1,132 @@
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testReport() Report {
+	joined := time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+	return Report{
+		Numeric:     []numericSummary{{Name: "age", Mean: 30, StdDev: 5, Min: 1, Max: 80, Q25: 20, Q50: 30, Q75: 40}},
+		Categorical: []categoricalSummary{{Name: "sex", UniqueCount: 2, Mode: "male", TopFrequent: []string{"male", "female"}}},
+		Bool:        []boolSummary{{Name: "active", TrueCount: 7, FalseCount: 3}},
+		Date:        []dateSummary{{Name: "joined", Min: joined, Max: joined, CommonWeekday: joined.Weekday()}},
+		Missingness: MissingnessReport{
+			Columns: []string{"age", "sex", "active", "joined"},
+			Stats: map[string]MissingStats{
+				"age":    {Count: 1, Fraction: 0.1},
+				"sex":    {Count: 0, Fraction: 0},
+				"active": {Count: 0, Fraction: 0},
+				"joined": {Count: 0, Fraction: 0},
+			},
+		},
+	}
+}
+
+func TestReporterForKnownFormats(t *testing.T) {
+	for _, format := range []string{"", "text", "json", "csv", "markdown", "md", "html"} {
+		if _, err := ReporterFor(format, ','); err != nil {
+			t.Errorf("ReporterFor(%q) returned error: %v", format, err)
+		}
+	}
+}
+
+func TestReporterForUnknownFormat(t *testing.T) {
+	if _, err := ReporterFor("yaml", ','); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
+
+func TestReportersProduceOutput(t *testing.T) {
+	report := testReport()
+
+	reporters := map[string]Reporter{
+		"text":     TextReporter{},
+		"json":     JSONReporter{},
+		"csv":      CSVReporter{Separator: ','},
+		"markdown": MarkdownReporter{},
+		"html":     HTMLReporter{},
+	}
+
+	for name, rep := range reporters {
+		var buf bytes.Buffer
+		if err := rep.Render(&buf, report); err != nil {
+			t.Fatalf("%s: Render returned error: %v", name, err)
+		}
+		out := buf.String()
+		for _, col := range []string{"age", "sex", "active", "joined"} {
+			if !strings.Contains(out, col) {
+				t.Errorf("%s: expected output to mention column %q, got %q", name, col, out)
+			}
+		}
+	}
+}
+
+// TestRenderPerFormatStaysWellFormed goes through ReporterFor and the
+// package-level Render (not Reporter.Render directly), so it exercises the
+// same path the CLI uses, and checks that the json, csv and html formats'
+// missingness sections don't corrupt the surrounding output.
+func TestRenderPerFormatStaysWellFormed(t *testing.T) {
+	report := testReport()
+
+	t.Run("json", func(t *testing.T) {
+		rep, err := ReporterFor("json", ',')
+		if err != nil {
+			t.Fatalf("ReporterFor: %v", err)
+		}
+		var buf bytes.Buffer
+		if err := Render(&buf, rep, report); err != nil {
+			t.Fatalf("Render: %v", err)
+		}
+
+		var decoded struct {
+			Numeric     []numericSummary     `json:"numeric"`
+			Categorical []categoricalSummary `json:"categorical"`
+			Bool        []boolSummary        `json:"bool"`
+			Date        []dateSummary        `json:"date"`
+			Missingness MissingnessReport    `json:"missingness"`
+		}
+		if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+			t.Fatalf("json.Unmarshal failed on Render output: %v\noutput: %s", err, buf.String())
+		}
+		if len(decoded.Bool) != 1 || len(decoded.Date) != 1 {
+			t.Errorf("decoded bool/date = %v/%v, want 1 entry each", decoded.Bool, decoded.Date)
+		}
+		if len(decoded.Missingness.Columns) != 4 {
+			t.Errorf("decoded missingness columns = %v, want 4", decoded.Missingness.Columns)
+		}
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		rep, err := ReporterFor("csv", ',')
+		if err != nil {
+			t.Fatalf("ReporterFor: %v", err)
+		}
+		var buf bytes.Buffer
+		if err := Render(&buf, rep, report); err != nil {
+			t.Fatalf("Render: %v", err)
+		}
+		if !strings.Contains(buf.String(), "missing_count") {
+			t.Errorf("expected a missingness table header, got: %s", buf.String())
+		}
+	})
+
+	t.Run("html", func(t *testing.T) {
+		rep, err := ReporterFor("html", ',')
+		if err != nil {
+			t.Fatalf("ReporterFor: %v", err)
+		}
+		var buf bytes.Buffer
+		if err := Render(&buf, rep, report); err != nil {
+			t.Fatalf("Render: %v", err)
+		}
+		out := buf.String()
+		if !strings.HasSuffix(strings.TrimRight(out, "\n"), "</html>") {
+			t.Errorf("expected output to end with </html>, got: %s", out)
+		}
+	})
+}
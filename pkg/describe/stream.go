@@ -0,0 +1,247 @@
+package describe
+
+import (
+	"encoding/csv"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// cmsWidth and cmsDepth size each column's countMinSketch. At this size the
+// sketch uses ~32KB per categorical column and keeps relative error on
+// heavy-hitter counts under 1% for columns with a few million rows.
+const (
+	cmsWidth = 2048
+	cmsDepth = 4
+	topKSize = 16 // candidates tracked per column; TopFrequent reports the top 3
+)
+
+type streamColumnKind int
+
+const (
+	streamKindUnknown streamColumnKind = iota
+	streamKindNumeric
+	streamKindCategorical
+)
+
+// streamNumericAccumulator maintains O(1)-memory running statistics for a
+// numeric column: Welford's algorithm for mean/variance, and a P²
+// estimator per quantile.
+type streamNumericAccumulator struct {
+	count         int
+	mean, m2      float64
+	min, max      float64
+	q25, q50, q75 *p2Estimator
+}
+
+func newStreamNumericAccumulator() *streamNumericAccumulator {
+	return &streamNumericAccumulator{
+		q25: newP2Estimator(0.25),
+		q50: newP2Estimator(0.50),
+		q75: newP2Estimator(0.75),
+	}
+}
+
+func (a *streamNumericAccumulator) Add(x float64) {
+	a.count++
+	delta := x - a.mean
+	a.mean += delta / float64(a.count)
+	a.m2 += delta * (x - a.mean)
+
+	if a.count == 1 || x < a.min {
+		a.min = x
+	}
+	if a.count == 1 || x > a.max {
+		a.max = x
+	}
+
+	a.q25.Add(x)
+	a.q50.Add(x)
+	a.q75.Add(x)
+}
+
+func (a *streamNumericAccumulator) Summary(name string) numericSummary {
+	var stddev float64
+	if a.count > 0 {
+		stddev = math.Sqrt(a.m2 / float64(a.count))
+	}
+	return numericSummary{
+		Name:   name,
+		Min:    a.min,
+		Max:    a.max,
+		Mean:   a.mean,
+		Median: a.q50.Value(),
+		StdDev: stddev,
+		Q25:    a.q25.Value(),
+		Q50:    a.q50.Value(),
+		Q75:    a.q75.Value(),
+	}
+}
+
+// streamCategoricalAccumulator approximates a categorical column's mode and
+// top-frequent values with a countMinSketch plus a bounded top-K heap,
+// avoiding the need to retain every raw value. UniqueCount is still exact
+// (it requires O(distinct values) memory); an approximate cardinality
+// estimator such as HyperLogLog would be needed to bound that too.
+type streamCategoricalAccumulator struct {
+	cms     *countMinSketch
+	top     *topKHeap
+	uniques map[string]struct{}
+}
+
+func newStreamCategoricalAccumulator() *streamCategoricalAccumulator {
+	return &streamCategoricalAccumulator{
+		cms:     newCountMinSketch(cmsWidth, cmsDepth),
+		top:     newTopKHeap(topKSize),
+		uniques: make(map[string]struct{}),
+	}
+}
+
+func (a *streamCategoricalAccumulator) Add(v string) {
+	a.cms.Add(v)
+	a.uniques[v] = struct{}{}
+	a.top.Observe(v, a.cms.Estimate(v))
+}
+
+func (a *streamCategoricalAccumulator) Summary(name string) categoricalSummary {
+	top := a.top.Top()
+
+	var mode string
+	if len(top) > 0 {
+		mode = top[0].Value
+	}
+
+	n := 3
+	if len(top) < n {
+		n = len(top)
+	}
+	topFrequent := make([]string, n)
+	for i := 0; i < n; i++ {
+		topFrequent[i] = top[i].Value
+	}
+
+	freqs := make(map[string]int, len(top))
+	for _, hh := range top {
+		freqs[hh.Value] = int(hh.Count)
+	}
+
+	return categoricalSummary{
+		Name:        name,
+		UniqueCount: len(a.uniques),
+		Mode:        mode,
+		TopFrequent: topFrequent,
+		Frequencies: freqs,
+	}
+}
+
+// StreamCSV computes a Report from r in a single pass, without materializing
+// any column in memory: numeric columns are summarized with Welford's
+// algorithm and P² quantile estimators, and categorical columns with a
+// count-min sketch and a bounded heavy-hitters heap. This trades the exact
+// answers ReadCSV+Describe gives for fixed per-column memory regardless of
+// row count, except that each categorical column keeps every distinct value
+// to report an exact UniqueCount; Mean/StdDev/Min/Max are exact, quantiles
+// and categorical Mode/TopFrequent/Frequencies are approximate.
+//
+// A column's kind (numeric vs categorical) is decided by its first
+// non-missing value; later values that don't match a numeric column's kind
+// are dropped rather than flipping the column to categorical.
+func StreamCSV(r io.Reader, opts Options) (Report, error) {
+	r, err := decodeReader(r, opts.Encoding)
+	if err != nil {
+		return Report{}, err
+	}
+
+	reader := csv.NewReader(r)
+	reader.Comma = opts.Separator
+	reader.TrimLeadingSpace = true
+
+	var headers []string
+	kinds := make(map[string]streamColumnKind)
+	numeric := make(map[string]*streamNumericAccumulator)
+	categorical := make(map[string]*streamCategoricalAccumulator)
+
+	firstRow, err := reader.Read()
+	if err == io.EOF {
+		return Report{}, nil
+	}
+	if err != nil {
+		return Report{}, err
+	}
+
+	if opts.HasHeader {
+		headers = firstRow
+	} else {
+		headers = make([]string, len(firstRow))
+		for i := range firstRow {
+			headers[i] = "col" + strconv.Itoa(i+1)
+		}
+		addStreamRow(firstRow, headers, opts, kinds, numeric, categorical)
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return Report{}, err
+		}
+		addStreamRow(record, headers, opts, kinds, numeric, categorical)
+	}
+
+	var report Report
+	for name, acc := range numeric {
+		report.Numeric = append(report.Numeric, acc.Summary(name))
+	}
+	for name, acc := range categorical {
+		report.Categorical = append(report.Categorical, acc.Summary(name))
+	}
+
+	// numeric/categorical are plain maps, so iteration order above is
+	// random; sort by name for deterministic output, same as Describe.
+	sort.Slice(report.Numeric, func(i, j int) bool { return report.Numeric[i].Name < report.Numeric[j].Name })
+	sort.Slice(report.Categorical, func(i, j int) bool { return report.Categorical[i].Name < report.Categorical[j].Name })
+
+	return report, nil
+}
+
+func addStreamRow(
+	record, headers []string,
+	opts Options,
+	kinds map[string]streamColumnKind,
+	numeric map[string]*streamNumericAccumulator,
+	categorical map[string]*streamCategoricalAccumulator,
+) {
+	for i, val := range record {
+		if i >= len(headers) {
+			continue
+		}
+		name := headers[i]
+		if isMissing(val, opts) {
+			continue
+		}
+
+		switch kinds[name] {
+		case streamKindNumeric:
+			if f, err := strconv.ParseFloat(val, 64); err == nil {
+				numeric[name].Add(f)
+			}
+		case streamKindCategorical:
+			categorical[name].Add(val)
+		default:
+			if f, err := strconv.ParseFloat(val, 64); err == nil {
+				kinds[name] = streamKindNumeric
+				acc := newStreamNumericAccumulator()
+				acc.Add(f)
+				numeric[name] = acc
+			} else {
+				kinds[name] = streamKindCategorical
+				acc := newStreamCategoricalAccumulator()
+				acc.Add(val)
+				categorical[name] = acc
+			}
+		}
+	}
+}
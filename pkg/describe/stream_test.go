@@ -0,0 +1,54 @@
+package describe
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStreamCSVSummarizesColumns(t *testing.T) {
+	csvData := "age,city\n20,paris\n30,paris\n40,berlin\n"
+	opts := DefaultOptions()
+
+	report, err := StreamCSV(strings.NewReader(csvData), opts)
+	if err != nil {
+		t.Fatalf("StreamCSV returned error: %v", err)
+	}
+
+	if len(report.Numeric) != 1 || report.Numeric[0].Name != "age" {
+		t.Fatalf("expected one numeric column 'age', got %+v", report.Numeric)
+	}
+	if got, want := report.Numeric[0].Mean, 30.0; got != want {
+		t.Errorf("age mean = %v, want %v", got, want)
+	}
+
+	if len(report.Categorical) != 1 || report.Categorical[0].Name != "city" {
+		t.Fatalf("expected one categorical column 'city', got %+v", report.Categorical)
+	}
+	if got, want := report.Categorical[0].Mode, "paris"; got != want {
+		t.Errorf("city mode = %q, want %q", got, want)
+	}
+	if got, want := report.Categorical[0].UniqueCount, 2; got != want {
+		t.Errorf("city unique count = %d, want %d", got, want)
+	}
+}
+
+func TestStreamCSVOrdersColumnsDeterministically(t *testing.T) {
+	csvData := "zeta,mid,alpha\n1,2,3\n4,5,6\n7,8,9\n"
+
+	for i := 0; i < 20; i++ {
+		report, err := StreamCSV(strings.NewReader(csvData), DefaultOptions())
+		if err != nil {
+			t.Fatalf("StreamCSV returned error: %v", err)
+		}
+		if len(report.Numeric) != 3 {
+			t.Fatalf("report.Numeric has %d entries, want 3", len(report.Numeric))
+		}
+		got := []string{report.Numeric[0].Name, report.Numeric[1].Name, report.Numeric[2].Name}
+		want := []string{"alpha", "mid", "zeta"}
+		for j := range want {
+			if got[j] != want[j] {
+				t.Fatalf("run %d: report.Numeric order = %v, want %v", i, got, want)
+			}
+		}
+	}
+}
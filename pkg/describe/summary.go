@@ -0,0 +1,268 @@
+package describe
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// numericSummary holds descriptive statistics for a single numeric column.
+type numericSummary struct {
+	Name    string
+	Min     float64
+	Max     float64
+	Mean    float64
+	Median  float64
+	StdDev  float64
+	Q25     float64
+	Q50     float64
+	Q75     float64
+	Missing MissingStats
+}
+
+// categoricalSummary holds descriptive statistics for a single categorical
+// column.
+type categoricalSummary struct {
+	Name        string
+	UniqueCount int
+	Mode        string
+	TopFrequent []string
+	Frequencies map[string]int
+	Missing     MissingStats
+}
+
+// boolSummary holds descriptive statistics for a single boolean column.
+type boolSummary struct {
+	Name       string
+	TrueCount  int
+	FalseCount int
+}
+
+// dateSummary holds descriptive statistics for a single date column.
+type dateSummary struct {
+	Name          string
+	Min           time.Time
+	Max           time.Time
+	Range         time.Duration
+	CommonWeekday time.Weekday
+}
+
+// Report is the result of describing a DataFrame: the per-column summaries
+// for its numeric, boolean, date, and categorical columns, plus an overall
+// missingness breakdown across all of them.
+type Report struct {
+	Numeric     []numericSummary
+	Bool        []boolSummary
+	Date        []dateSummary
+	Categorical []categoricalSummary
+	Missingness MissingnessReport
+}
+
+// Describe computes summary statistics for every column in df, processing
+// numeric and categorical columns concurrently.
+func (df *DataFrame) Describe() Report {
+	var report Report
+
+	catCh := make(chan categoricalSummary)
+	numCh := make(chan numericSummary)
+
+	var catWg sync.WaitGroup
+	var numWg sync.WaitGroup
+
+	catWg.Add(len(df.Categorical))
+	for colName, colVals := range df.Categorical {
+		go func(name string, vals []string) {
+			defer catWg.Done()
+			catCh <- processCategoricalCol(name, vals, df.MissingStats[name])
+		}(colName, colVals)
+	}
+
+	numWg.Add(len(df.Numeric))
+	for colName, colVals := range df.Numeric {
+		go func(name string, vals []float64) {
+			defer numWg.Done()
+			numCh <- processNumericCol(name, vals, df.MissingStats[name])
+		}(colName, colVals)
+	}
+
+	go func() {
+		catWg.Wait()
+		close(catCh)
+	}()
+
+	go func() {
+		numWg.Wait()
+		close(numCh)
+	}()
+
+	for s := range catCh {
+		report.Categorical = append(report.Categorical, s)
+	}
+	for s := range numCh {
+		report.Numeric = append(report.Numeric, s)
+	}
+
+	for name, vals := range df.Bool {
+		report.Bool = append(report.Bool, processBoolCol(name, vals))
+	}
+	for name, vals := range df.Date {
+		report.Date = append(report.Date, processDateCol(name, vals))
+	}
+
+	// Collection above goes through goroutines/channels (Numeric,
+	// Categorical) or unordered map iteration (Bool, Date), so every
+	// section needs an explicit sort to keep Describe's output
+	// deterministic across runs on the same input.
+	sort.Slice(report.Numeric, func(i, j int) bool { return report.Numeric[i].Name < report.Numeric[j].Name })
+	sort.Slice(report.Categorical, func(i, j int) bool { return report.Categorical[i].Name < report.Categorical[j].Name })
+	sort.Slice(report.Bool, func(i, j int) bool { return report.Bool[i].Name < report.Bool[j].Name })
+	sort.Slice(report.Date, func(i, j int) bool { return report.Date[i].Name < report.Date[j].Name })
+
+	report.Missingness = MissingnessReport{Columns: df.Headers, Stats: df.MissingStats}
+
+	return report
+}
+
+func processBoolCol(name string, vals []bool) boolSummary {
+	s := boolSummary{Name: name}
+	for _, v := range vals {
+		if v {
+			s.TrueCount++
+		} else {
+			s.FalseCount++
+		}
+	}
+	return s
+}
+
+func processDateCol(name string, vals []time.Time) dateSummary {
+	if len(vals) == 0 {
+		return dateSummary{Name: name}
+	}
+
+	min, max := vals[0], vals[0]
+	weekdayCounts := make(map[time.Weekday]int)
+	for _, t := range vals {
+		if t.Before(min) {
+			min = t
+		}
+		if t.After(max) {
+			max = t
+		}
+		weekdayCounts[t.Weekday()]++
+	}
+
+	var commonWeekday time.Weekday
+	var maxCount int
+	for wd, count := range weekdayCounts {
+		if count > maxCount {
+			commonWeekday = wd
+			maxCount = count
+		}
+	}
+
+	return dateSummary{
+		Name:          name,
+		Min:           min,
+		Max:           max,
+		Range:         max.Sub(min),
+		CommonWeekday: commonWeekday,
+	}
+}
+
+func processNumericCol(name string, vals []float64, missing MissingStats) numericSummary {
+	if len(vals) == 0 {
+		return numericSummary{Name: name, Missing: missing}
+	}
+
+	vals = append([]float64(nil), vals...)
+	sort.Float64s(vals)
+
+	min := vals[0]
+	max := vals[len(vals)-1]
+
+	var sum float64
+	for _, v := range vals {
+		sum += v
+	}
+	mean := sum / float64(len(vals))
+
+	var variance float64
+	for _, v := range vals {
+		variance += math.Pow(v-mean, 2)
+	}
+	stddev := math.Sqrt(variance / float64(len(vals)))
+
+	mid := len(vals) / 2
+	var median float64
+	if len(vals)%2 == 0 {
+		median = (vals[mid-1] + vals[mid]) / 2
+	} else {
+		median = vals[mid]
+	}
+
+	q25 := vals[len(vals)*25/100]
+	q50 := median
+	q75 := vals[len(vals)*75/100]
+
+	return numericSummary{
+		Name:    name,
+		Min:     min,
+		Max:     max,
+		Mean:    mean,
+		Median:  median,
+		StdDev:  stddev,
+		Q25:     q25,
+		Q50:     q50,
+		Q75:     q75,
+		Missing: missing,
+	}
+}
+
+func processCategoricalCol(name string, vals []string, missing MissingStats) categoricalSummary {
+	counts := make(map[string]int)
+	for _, v := range vals {
+		counts[v]++
+	}
+
+	var mode string
+	var maxCount int
+	for val, count := range counts {
+		if count > maxCount {
+			mode = val
+			maxCount = count
+		}
+	}
+
+	// Sort values by frequency
+	type kv struct {
+		Key   string
+		Value int
+	}
+	var sorted []kv
+	for k, v := range counts {
+		sorted = append(sorted, kv{k, v})
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Value > sorted[j].Value
+	})
+
+	topN := 3
+	if len(sorted) < topN {
+		topN = len(sorted)
+	}
+	topFrequent := make([]string, topN)
+	for i := 0; i < topN; i++ {
+		topFrequent[i] = sorted[i].Key
+	}
+
+	return categoricalSummary{
+		Name:        name,
+		UniqueCount: len(counts),
+		Mode:        mode,
+		TopFrequent: topFrequent,
+		Frequencies: counts,
+		Missing:     missing,
+	}
+}
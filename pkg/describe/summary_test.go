@@ -0,0 +1,59 @@
+package describe
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDescribeOrdersColumnsDeterministically(t *testing.T) {
+	csvData := "zeta,mid,alpha\n1,2,3\n4,5,6\n7,8,9\n"
+
+	for i := 0; i < 20; i++ {
+		df, err := ReadCSV(strings.NewReader(csvData), DefaultOptions())
+		if err != nil {
+			t.Fatalf("ReadCSV returned error: %v", err)
+		}
+
+		report := df.Describe()
+		if len(report.Numeric) != 3 {
+			t.Fatalf("report.Numeric has %d entries, want 3", len(report.Numeric))
+		}
+		got := []string{report.Numeric[0].Name, report.Numeric[1].Name, report.Numeric[2].Name}
+		want := []string{"alpha", "mid", "zeta"}
+		for j := range want {
+			if got[j] != want[j] {
+				t.Fatalf("run %d: report.Numeric order = %v, want %v", i, got, want)
+			}
+		}
+	}
+}
+
+func TestDescribeHandlesColumnWithNoParsableValues(t *testing.T) {
+	// With MissingThreshold = 1.0, "age" is still inferred as KindInt (a
+	// 100% failure rate is within a 1.0 threshold) even though every value
+	// fails to parse, leaving df.Numeric["age"] empty. Describe must not
+	// panic indexing into that empty slice.
+	csvData := "age\nxxx\nyyy\nzzz\n"
+	opts := DefaultOptions()
+	opts.MissingThreshold = 1.0
+
+	df, err := ReadCSV(strings.NewReader(csvData), opts)
+	if err != nil {
+		t.Fatalf("ReadCSV returned error: %v", err)
+	}
+	if df.Kinds["age"] != KindInt {
+		t.Fatalf("age kind = %v, want %v", df.Kinds["age"], KindInt)
+	}
+	if len(df.Numeric["age"]) != 0 {
+		t.Fatalf("age should have no parsable values, got %d", len(df.Numeric["age"]))
+	}
+
+	report := df.Describe()
+
+	if len(report.Numeric) != 1 {
+		t.Fatalf("report.Numeric has %d entries, want 1", len(report.Numeric))
+	}
+	if got := report.Numeric[0].Missing.Count; got != 3 {
+		t.Errorf("age missing count = %d, want 3", got)
+	}
+}
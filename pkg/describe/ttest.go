@@ -0,0 +1,85 @@
+package describe
+
+import (
+	"fmt"
+	"math"
+)
+
+// TTestResult is the outcome of Welch's t-test comparing a numeric column
+// across the two groups of a binary categorical column.
+type TTestResult struct {
+	Statistic float64
+	DOF       float64
+	PValue    float64
+}
+
+// TTest runs Welch's t-test (unequal-variance) comparing numericCol between
+// the two groups defined by groupCol, which must be categorical with
+// exactly two distinct, non-missing values. numericCol and groupCol are
+// matched up by original row, not by position, since numericCol may have
+// dropped missing rows that groupCol kept (see DataFrame.NumericRows).
+func (df *DataFrame) TTest(numericCol, groupCol string) (TTestResult, error) {
+	values, ok := df.Numeric[numericCol]
+	if !ok {
+		return TTestResult{}, fmt.Errorf("describe: column %q is not numeric", numericCol)
+	}
+	groups, ok := df.Categorical[groupCol]
+	if !ok {
+		return TTestResult{}, fmt.Errorf("describe: column %q is not categorical", groupCol)
+	}
+	rows := df.numericRowIndices(numericCol)
+
+	levels := uniqueOrdered(groups)
+	if len(levels) != 2 {
+		return TTestResult{}, fmt.Errorf("describe: column %q must have exactly 2 groups, got %d", groupCol, len(levels))
+	}
+
+	var a, b []float64
+	for i, row := range rows {
+		if row >= len(groups) {
+			return TTestResult{}, fmt.Errorf("describe: columns %q and %q have different lengths", numericCol, groupCol)
+		}
+		switch groups[row] {
+		case levels[0]:
+			a = append(a, values[i])
+		case levels[1]:
+			b = append(b, values[i])
+		}
+	}
+	if len(a) < 2 || len(b) < 2 {
+		return TTestResult{}, fmt.Errorf("describe: both groups of %q need at least 2 rows", groupCol)
+	}
+
+	meanA, varA := meanAndVariance(a)
+	meanB, varB := meanAndVariance(b)
+	nA, nB := float64(len(a)), float64(len(b))
+
+	seA, seB := varA/nA, varB/nB
+	se := math.Sqrt(seA + seB)
+	if se == 0 {
+		return TTestResult{}, fmt.Errorf("describe: both groups of %q have zero variance", groupCol)
+	}
+
+	statistic := (meanA - meanB) / se
+	dof := math.Pow(seA+seB, 2) / (math.Pow(seA, 2)/(nA-1) + math.Pow(seB, 2)/(nB-1))
+
+	return TTestResult{
+		Statistic: statistic,
+		DOF:       dof,
+		PValue:    tDistributionPValue(statistic, dof),
+	}, nil
+}
+
+func meanAndVariance(vals []float64) (mean, variance float64) {
+	for _, v := range vals {
+		mean += v
+	}
+	mean /= float64(len(vals))
+
+	for _, v := range vals {
+		variance += (v - mean) * (v - mean)
+	}
+	variance /= float64(len(vals) - 1)
+
+	return mean, variance
+}
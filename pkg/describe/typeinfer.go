@@ -0,0 +1,153 @@
+package describe
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ColumnKind identifies the inferred type of a CSV column.
+type ColumnKind int
+
+const (
+	KindUnknown ColumnKind = iota
+	KindInt
+	KindFloat
+	KindBool
+	KindDate
+	KindCategorical
+)
+
+func (k ColumnKind) String() string {
+	switch k {
+	case KindInt:
+		return "int"
+	case KindFloat:
+		return "float"
+	case KindBool:
+		return "bool"
+	case KindDate:
+		return "date"
+	case KindCategorical:
+		return "categorical"
+	default:
+		return "unknown"
+	}
+}
+
+// dateLayouts are tried in order by parseDate; the first one that matches a
+// value wins.
+var dateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02",
+	"2006/01/02",
+	"01/02/2006",
+	"01-02-2006",
+}
+
+// inferColumnKind classifies values by trying, in order of precedence, int,
+// float (including "$1,200.00"/"45%"-style strings), bool, and date
+// parsing: the first kind whose failure rate is within opts.MissingThreshold
+// wins. Values that fail to parse as the chosen kind are treated as missing
+// by the caller. An empty column, or one where no kind clears the
+// threshold, is KindCategorical.
+func inferColumnKind(values []string, opts Options) ColumnKind {
+	total := 0
+	var intOK, floatOK, boolOK, dateOK int
+
+	for _, v := range values {
+		if isMissing(v, opts) {
+			continue
+		}
+		total++
+		if _, ok := parseInt(v); ok {
+			intOK++
+		}
+		if _, ok := parseNumericLike(v); ok {
+			floatOK++
+		}
+		if _, ok := parseBool(v); ok {
+			boolOK++
+		}
+		if _, ok := parseDate(v); ok {
+			dateOK++
+		}
+	}
+
+	if total == 0 {
+		return KindCategorical
+	}
+
+	failureRate := func(ok int) float64 {
+		return 1 - float64(ok)/float64(total)
+	}
+
+	switch {
+	case failureRate(intOK) <= opts.MissingThreshold:
+		return KindInt
+	case failureRate(floatOK) <= opts.MissingThreshold:
+		return KindFloat
+	case failureRate(boolOK) <= opts.MissingThreshold:
+		return KindBool
+	case failureRate(dateOK) <= opts.MissingThreshold:
+		return KindDate
+	default:
+		return KindCategorical
+	}
+}
+
+func parseInt(v string) (int64, bool) {
+	i, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return i, true
+}
+
+// parseNumericLike parses a float, tolerating the "$1,200.00" and "45%"
+// formatting that plain strconv.ParseFloat rejects: thousands separators
+// and a leading currency symbol are stripped, and a trailing "%" divides
+// the result by 100.
+func parseNumericLike(v string) (float64, bool) {
+	s := strings.TrimSpace(v)
+
+	isPercent := strings.HasSuffix(s, "%")
+	if isPercent {
+		s = strings.TrimSuffix(s, "%")
+	}
+
+	s = strings.TrimPrefix(s, "$")
+	s = strings.TrimPrefix(s, "€")
+	s = strings.TrimPrefix(s, "£")
+	s = strings.ReplaceAll(s, ",", "")
+
+	f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return 0, false
+	}
+	if isPercent {
+		f /= 100
+	}
+	return f, true
+}
+
+func parseBool(v string) (bool, bool) {
+	switch strings.ToLower(strings.TrimSpace(v)) {
+	case "true", "yes", "1":
+		return true, true
+	case "false", "no", "0":
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+func parseDate(v string) (time.Time, bool) {
+	s := strings.TrimSpace(v)
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,116 @@
+package describe
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReadCSVInfersIntBoolDateAndCategorical(t *testing.T) {
+	csvData := "id,active,signup_date,city\n" +
+		"1,true,2023-04-01,paris\n" +
+		"2,false,2023-04-02,berlin\n" +
+		"3,yes,2023-04-03,paris\n"
+
+	df, err := ReadCSV(strings.NewReader(csvData), DefaultOptions())
+	if err != nil {
+		t.Fatalf("ReadCSV returned error: %v", err)
+	}
+
+	if got := df.Kinds["id"]; got != KindInt {
+		t.Errorf("id kind = %v, want %v", got, KindInt)
+	}
+	if got := df.Kinds["active"]; got != KindBool {
+		t.Errorf("active kind = %v, want %v", got, KindBool)
+	}
+	if got := df.Kinds["signup_date"]; got != KindDate {
+		t.Errorf("signup_date kind = %v, want %v", got, KindDate)
+	}
+	if got := df.Kinds["city"]; got != KindCategorical {
+		t.Errorf("city kind = %v, want %v", got, KindCategorical)
+	}
+
+	if len(df.Bool["active"]) != 3 {
+		t.Errorf("active has %d values, want 3", len(df.Bool["active"]))
+	}
+	if len(df.Date["signup_date"]) != 3 {
+		t.Errorf("signup_date has %d values, want 3", len(df.Date["signup_date"]))
+	}
+}
+
+func TestReadCSVParsesCurrencyAndPercentAsFloat(t *testing.T) {
+	csvData := "price,discount\n\"$1,200.00\",45%\n$800.50,10%\n"
+
+	df, err := ReadCSV(strings.NewReader(csvData), DefaultOptions())
+	if err != nil {
+		t.Fatalf("ReadCSV returned error: %v", err)
+	}
+
+	if df.Kinds["price"] != KindFloat {
+		t.Fatalf("price kind = %v, want %v", df.Kinds["price"], KindFloat)
+	}
+	if got, want := df.Numeric["price"][0], 1200.0; got != want {
+		t.Errorf("price[0] = %v, want %v", got, want)
+	}
+	if got, want := df.Numeric["discount"][0], 0.45; got != want {
+		t.Errorf("discount[0] = %v, want %v", got, want)
+	}
+}
+
+func TestReadCSVMissingThresholdToleratesOutliers(t *testing.T) {
+	csvData := "age\n20\n30\nnot-a-number\n40\n"
+	opts := DefaultOptions()
+	opts.MissingThreshold = 0.34
+
+	df, err := ReadCSV(strings.NewReader(csvData), opts)
+	if err != nil {
+		t.Fatalf("ReadCSV returned error: %v", err)
+	}
+
+	if df.Kinds["age"] != KindInt {
+		t.Fatalf("age kind = %v, want %v", df.Kinds["age"], KindInt)
+	}
+	if got, want := len(df.Numeric["age"]), 3; got != want {
+		t.Errorf("age has %d values, want %d", got, want)
+	}
+}
+
+func TestReadCSVIntColumnDropsNonIntOutliers(t *testing.T) {
+	// "3.5" parses fine as a float but not as an int; with the column
+	// still inferred KindInt (its failure rate is within the threshold),
+	// "3.5" must be dropped and counted as missing, not kept as a float
+	// value smuggled into an int-typed column.
+	csvData := "age\n20\n30\n40\n50\n3.5\n"
+	opts := DefaultOptions()
+	opts.MissingThreshold = 0.3
+
+	df, err := ReadCSV(strings.NewReader(csvData), opts)
+	if err != nil {
+		t.Fatalf("ReadCSV returned error: %v", err)
+	}
+
+	if df.Kinds["age"] != KindInt {
+		t.Fatalf("age kind = %v, want %v", df.Kinds["age"], KindInt)
+	}
+	for _, v := range df.Numeric["age"] {
+		if v == 3.5 {
+			t.Errorf("age should not contain the non-int outlier 3.5, got %v", df.Numeric["age"])
+		}
+	}
+	if got, want := df.MissingStats["age"].Count, 1; got != want {
+		t.Errorf("age missing count = %d, want %d", got, want)
+	}
+}
+
+func TestReadCSVColumnTypesOverride(t *testing.T) {
+	csvData := "code\n007\n042\n"
+	opts := DefaultOptions()
+	opts.ColumnTypes = map[string]ColumnKind{"code": KindCategorical}
+
+	df, err := ReadCSV(strings.NewReader(csvData), opts)
+	if err != nil {
+		t.Fatalf("ReadCSV returned error: %v", err)
+	}
+	if df.Kinds["code"] != KindCategorical {
+		t.Errorf("code kind = %v, want %v (override)", df.Kinds["code"], KindCategorical)
+	}
+}